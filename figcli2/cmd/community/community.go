@@ -2,18 +2,31 @@ package community
 
 import (
 	"fmt"
-	"os/exec"
 
+	"github.com/aws/amazon-q-developer-cli/figcli2/internal/settings"
+	"github.com/pkg/browser"
 	"github.com/spf13/cobra"
 )
 
+// defaultCommunityURL is used when the user hasn't set community.url.
+// Setting community.url (e.g. to an internal Slack or Teams invite) makes
+// `q community` open that URL instead.
+const defaultCommunityURL = "https://fig.io/community"
+
 func NewCmdCommunity() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "community",
 		Short: "Join the Fig community",
-		Run: func(cmd *cobra.Command, arg []string) {
+		RunE: func(cmd *cobra.Command, arg []string) error {
+			url := defaultCommunityURL
+			if loaded, err := settings.Load(); err == nil {
+				if configured, ok := settings.GetString(loaded, "community.url"); ok {
+					url = configured
+				}
+			}
+
 			fmt.Printf("\n→ Joining Fig community....\n\n")
-			exec.Command("open", "https://fig.io/community").Run()
+			return browser.OpenURL(url)
 		},
 	}
 
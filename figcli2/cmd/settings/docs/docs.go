@@ -2,21 +2,162 @@ package docs
 
 import (
 	"fmt"
-	"os/exec"
+	"os"
+	"path/filepath"
+	"strings"
 
+	"github.com/aws/amazon-q-developer-cli/figcli2/internal/settings"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pkg/browser"
 	"github.com/spf13/cobra"
+	"github.com/spf13/cobra/doc"
 )
 
+// settingsDocsURL is what `docs --web` opens. A `docs <topic>` with neither
+// an offline setting nor a user-configured docs.topics.<topic> mapping opens
+// topicDocsURL(topic) instead.
+const settingsDocsURL = "https://fig.io/docs/support/settings"
+
+// topicDocsURL builds the built-in fig.io URL for an arbitrary docs topic,
+// e.g. "autocomplete" -> "https://fig.io/docs/support/autocomplete".
+func topicDocsURL(topic string) string {
+	return "https://fig.io/docs/support/" + topic
+}
+
 func NewCmdDocs() *cobra.Command {
+	var web bool
+	var search string
+
 	cmd := &cobra.Command{
-		Use:   "docs",
+		Use:   "docs [setting]",
 		Short: "Get the settings documentation",
 		Long:  "Get the settings documentation",
-		Run: func(cmd *cobra.Command, arg []string) {
-			fmt.Println("→ Opening Fig docs...")
-			exec.Command("open", "https://fig.io/docs/support/settings").Run()
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if web {
+				fmt.Println("→ Opening Fig docs...")
+				return browser.OpenURL(settingsDocsURL)
+			}
+
+			registry, err := loadSettings()
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case search != "":
+				matches := searchSettings(registry, search)
+				if len(matches) == 0 {
+					fmt.Printf("No settings matching %q\n", search)
+					return nil
+				}
+				for _, s := range matches {
+					fmt.Println(s)
+				}
+				return nil
+			case len(args) == 1:
+				topic := args[0]
+
+				for _, s := range registry {
+					if s.Key == topic {
+						fmt.Println(s)
+						return nil
+					}
+				}
+
+				url := topicDocsURL(topic)
+				if userSettings, err := settings.Load(); err == nil {
+					if configured, ok := settings.GetString(userSettings, "docs.topics."+topic); ok {
+						url = configured
+					}
+				}
+
+				fmt.Printf("→ Opening docs for %q...\n", topic)
+				return browser.OpenURL(url)
+			default:
+				p := tea.NewProgram(newBrowserModel(registry))
+				_, err := p.Run()
+				return err
+			}
 		},
 	}
 
+	cmd.Flags().BoolVar(&web, "web", false, "open the settings documentation in your browser instead")
+	cmd.Flags().StringVar(&search, "search", "", "fuzzy search the settings documentation for a term")
+
+	cmd.AddCommand(newCmdDocsGenerate())
+
 	return cmd
 }
+
+// newCmdDocsGenerate returns the hidden `docs generate` subcommand, which
+// walks the full command tree (starting from the root of the CLI, not just
+// the settings/docs subtree) and writes a reference for it to disk. This is
+// meant to be run as part of the release process to keep the fig.io
+// documentation site up to date, not by end users.
+func newCmdDocsGenerate() *cobra.Command {
+	var format string
+	var outputDir string
+
+	cmd := &cobra.Command{
+		Use:    "generate",
+		Short:  "Generate the CLI reference documentation",
+		Hidden: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			root := cmd.Root()
+
+			if err := os.MkdirAll(outputDir, 0o755); err != nil {
+				return fmt.Errorf("could not create output dir %q: %w", outputDir, err)
+			}
+
+			switch format {
+			case "markdown":
+				return doc.GenMarkdownTreeCustom(root, outputDir, filePrepender, linkHandler)
+			case "man":
+				return doc.GenManTree(root, &doc.GenManHeader{
+					Title:   "FIG",
+					Section: "1",
+				}, outputDir)
+			case "rest":
+				return doc.GenReSTTreeCustom(root, outputDir, filePrepender, restLinkHandler)
+			default:
+				return fmt.Errorf("unknown format %q, must be one of: markdown, man, rest", format)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "markdown", "the output format, one of: markdown, man, rest")
+	cmd.Flags().StringVar(&outputDir, "output-dir", "./docs", "the directory to write the generated docs to")
+
+	return cmd
+}
+
+// filePrepender adds no frontmatter of its own; fig.io's docs site generates
+// its own page metadata from the file name.
+func filePrepender(filename string) string {
+	return ""
+}
+
+// linkHandler rewrites the links cobra/doc generates between commands (e.g.
+// "fig_settings_docs.md") into the relative paths the fig.io docs site
+// expects (e.g. "/cli/settings/docs"). The root command's own page
+// ("fig.md") has no "fig_" prefix to strip, so it's special-cased to the
+// "/cli" index rather than leaking the literal "fig" segment into the path.
+func linkHandler(name string) string {
+	base := strings.TrimSuffix(name, filepath.Ext(name))
+	base = strings.ReplaceAll(base, "_", "/")
+
+	if base == "fig" {
+		return "/cli"
+	}
+
+	return "/cli/" + strings.TrimPrefix(base, "fig/")
+}
+
+// restLinkHandler adapts linkHandler to the (name, ref string) signature
+// GenReSTTreeCustom expects: name is the space-separated command path (e.g.
+// "fig settings docs") and ref is its underscore-joined anchor, which is the
+// same form linkHandler already rewrites for markdown/rest filenames.
+func restLinkHandler(name, ref string) string {
+	return linkHandler(ref + ".rst")
+}
@@ -0,0 +1,50 @@
+package docs
+
+import "testing"
+
+func TestLinkHandler(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"root command", "fig.md", "/cli"},
+		{"top level subcommand", "fig_docs.md", "/cli/docs"},
+		{"nested subcommand", "fig_settings_docs.md", "/cli/settings/docs"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := linkHandler(tc.in)
+			if got != tc.want {
+				t.Errorf("linkHandler(%q) = %q, want %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFilePrepender(t *testing.T) {
+	if got := filePrepender("fig_docs.md"); got != "" {
+		t.Errorf("filePrepender(...) = %q, want empty string", got)
+	}
+}
+
+func TestRestLinkHandler(t *testing.T) {
+	cases := []struct {
+		name string
+		ref  string
+		want string
+	}{
+		{"root command", "fig", "/cli"},
+		{"nested subcommand", "fig_settings_docs", "/cli/settings/docs"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := restLinkHandler("ignored", tc.ref)
+			if got != tc.want {
+				t.Errorf("restLinkHandler(_, %q) = %q, want %q", tc.ref, got, tc.want)
+			}
+		})
+	}
+}
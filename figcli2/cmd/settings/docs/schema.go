@@ -0,0 +1,84 @@
+package docs
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// settingsJSON is a manually maintained fixture mirroring the settings
+// registry, embedded so `q docs` works fully offline. There is no generator
+// for it yet, so it must be kept in sync by hand as settings are added or
+// changed; see settings.json.
+//
+//go:embed settings.json
+var settingsJSON []byte
+
+// Setting describes a single entry in the settings registry.
+type Setting struct {
+	Key         string   `json:"key"`
+	Description string   `json:"description"`
+	Type        string   `json:"type"`
+	Default     any      `json:"default"`
+	Allowed     []string `json:"allowed,omitempty"`
+}
+
+func loadSettings() ([]Setting, error) {
+	var settings []Setting
+	if err := json.Unmarshal(settingsJSON, &settings); err != nil {
+		return nil, fmt.Errorf("could not parse embedded settings schema: %w", err)
+	}
+
+	sort.Slice(settings, func(i, j int) bool {
+		return settings[i].Key < settings[j].Key
+	})
+
+	return settings, nil
+}
+
+func (s Setting) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s\n\n", s.Key)
+	fmt.Fprintf(&b, "  %s\n\n", s.Description)
+	fmt.Fprintf(&b, "  type:    %s\n", s.Type)
+	fmt.Fprintf(&b, "  default: %v\n", s.Default)
+
+	if len(s.Allowed) > 0 {
+		fmt.Fprintf(&b, "  allowed: %s\n", strings.Join(s.Allowed, ", "))
+	}
+
+	return b.String()
+}
+
+// fuzzyMatch reports whether term matches s's key, loosely: every rune in
+// term must appear in s.Key in order, ignoring case. This mirrors the
+// matching used by the autocomplete popup itself.
+func fuzzyMatch(term string, s Setting) bool {
+	termRunes := []rune(strings.ToLower(term))
+	key := strings.ToLower(s.Key)
+
+	i := 0
+	for _, r := range key {
+		if i >= len(termRunes) {
+			break
+		}
+		if r == termRunes[i] {
+			i++
+		}
+	}
+
+	return i == len(termRunes)
+}
+
+func searchSettings(settings []Setting, term string) []Setting {
+	var matches []Setting
+	for _, s := range settings {
+		if fuzzyMatch(term, s) {
+			matches = append(matches, s)
+		}
+	}
+	return matches
+}
@@ -0,0 +1,48 @@
+package docs
+
+import "testing"
+
+func TestFuzzyMatch(t *testing.T) {
+	cases := []struct {
+		name string
+		term string
+		key  string
+		want bool
+	}{
+		{"empty term matches everything", "", "autocomplete.width", true},
+		{"subsequence in order matches", "acw", "autocomplete.width", true},
+		{"case insensitive exact match", "THEME", "theme", true},
+		{"case insensitive subsequence", "AUTOW", "autocomplete.width", true},
+		{"out of order does not match", "wac", "autocomplete.width", false},
+		{"unicode term matches unicode key", "café", "café", true},
+		{"unicode mismatch", "cafz", "café", false},
+		{"term longer than key does not match", "autocomplete.width.extra", "autocomplete.width", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := fuzzyMatch(tc.term, Setting{Key: tc.key})
+			if got != tc.want {
+				t.Errorf("fuzzyMatch(%q, %q) = %v, want %v", tc.term, tc.key, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSearchSettings(t *testing.T) {
+	settings := []Setting{
+		{Key: "autocomplete.width"},
+		{Key: "autocomplete.disable"},
+		{Key: "theme"},
+	}
+
+	matches := searchSettings(settings, "auto")
+	if len(matches) != 2 {
+		t.Fatalf("expected 2 matches for %q, got %d: %v", "auto", len(matches), matches)
+	}
+
+	matches = searchSettings(settings, "zzz")
+	if len(matches) != 0 {
+		t.Fatalf("expected no matches for %q, got %d: %v", "zzz", len(matches), matches)
+	}
+}
@@ -0,0 +1,100 @@
+package docs
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	selectedStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("5"))
+	descStyle     = lipgloss.NewStyle().Faint(true)
+)
+
+// browserModel is a small bubbletea program that lets a user fuzzy search
+// the embedded settings schema and view the description, type, default, and
+// allowed values of whatever is selected.
+type browserModel struct {
+	all      []Setting
+	filtered []Setting
+	input    string
+	cursor   int
+}
+
+func newBrowserModel(settings []Setting) browserModel {
+	return browserModel{
+		all:      settings,
+		filtered: settings,
+	}
+}
+
+func (m browserModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m browserModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch keyMsg.Type {
+	case tea.KeyCtrlC, tea.KeyEsc:
+		return m, tea.Quit
+	case tea.KeyUp:
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case tea.KeyDown:
+		if m.cursor < len(m.filtered)-1 {
+			m.cursor++
+		}
+	case tea.KeyBackspace:
+		if len(m.input) > 0 {
+			m.input = m.input[:len(m.input)-1]
+			m.refilter()
+		}
+	case tea.KeyRunes:
+		m.input += string(keyMsg.Runes)
+		m.refilter()
+	}
+
+	return m, nil
+}
+
+func (m *browserModel) refilter() {
+	if m.input == "" {
+		m.filtered = m.all
+	} else {
+		m.filtered = searchSettings(m.all, m.input)
+	}
+	m.cursor = 0
+}
+
+func (m browserModel) View() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Search settings: %s█\n\n", m.input)
+
+	if len(m.filtered) == 0 {
+		b.WriteString(descStyle.Render("  no matching settings"))
+		return b.String()
+	}
+
+	for i, s := range m.filtered {
+		line := fmt.Sprintf("  %s", s.Key)
+		if i == m.cursor {
+			line = selectedStyle.Render(fmt.Sprintf("> %s", s.Key))
+		}
+		fmt.Fprintln(&b, line)
+	}
+
+	b.WriteString("\n")
+	b.WriteString(descStyle.Render(m.filtered[m.cursor].String()))
+	b.WriteString("\n\n")
+	b.WriteString(descStyle.Render("↑/↓ to navigate · esc to quit"))
+
+	return b.String()
+}
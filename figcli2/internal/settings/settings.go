@@ -0,0 +1,70 @@
+// Package settings reads the user's local Fig settings file, the same one
+// the autocomplete popup and `fig settings` read and write.
+package settings
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Path returns the location of the user's settings file, ~/.fig/settings.json.
+func Path() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".fig", "settings.json"), nil
+}
+
+// Load reads and parses the user's settings file. A missing file is not an
+// error; it is treated as an empty settings object so callers can always
+// fall back to their own defaults.
+func Load() (map[string]any, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return map[string]any{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var settings map[string]any
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// GetString looks up a dot-separated key, e.g. "docs.topics.autocomplete" or
+// "community.url", and returns its value along with whether it was set to a
+// non-empty string.
+func GetString(settings map[string]any, key string) (string, bool) {
+	parts := strings.Split(key, ".")
+
+	var cur any = settings
+	for _, part := range parts {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		cur, ok = m[part]
+		if !ok {
+			return "", false
+		}
+	}
+
+	str, ok := cur.(string)
+	if !ok || str == "" {
+		return "", false
+	}
+
+	return str, true
+}
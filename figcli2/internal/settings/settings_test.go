@@ -0,0 +1,44 @@
+package settings
+
+import "testing"
+
+func TestGetString(t *testing.T) {
+	data := map[string]any{
+		"community": map[string]any{
+			"url": "https://fig.io/community",
+		},
+		"docs": map[string]any{
+			"topics": map[string]any{
+				"autocomplete": "https://fig.io/docs/autocomplete",
+				"empty":        "",
+			},
+		},
+		"theme":  "dark",
+		"width":  40,
+		"nested": "not-a-map",
+	}
+
+	cases := []struct {
+		name   string
+		key    string
+		want   string
+		wantOk bool
+	}{
+		{"top level string", "theme", "dark", true},
+		{"nested key", "community.url", "https://fig.io/community", true},
+		{"deeply nested key", "docs.topics.autocomplete", "https://fig.io/docs/autocomplete", true},
+		{"missing key", "does.not.exist", "", false},
+		{"non-string leaf", "width", "", false},
+		{"empty string leaf treated as unset", "docs.topics.empty", "", false},
+		{"intermediate is not a map", "nested.child", "", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := GetString(data, tc.key)
+			if ok != tc.wantOk || got != tc.want {
+				t.Errorf("GetString(%q) = (%q, %v), want (%q, %v)", tc.key, got, ok, tc.want, tc.wantOk)
+			}
+		})
+	}
+}